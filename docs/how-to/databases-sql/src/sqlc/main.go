@@ -238,8 +238,8 @@ func (s *BlogService) queryPostsWithJoins(ctx context.Context) ([]PostWithAuthor
 	err := sqlc.From("posts").As("p").
 		Columns("p.id", "p.author_id", "p.title", "p.body", "p.status", "p.created_at", "p.updated_at").
 		LeftJoin("authors").As("a").On("p.author_id = a.id").
-		Column(sqlc.Col("a.name").As("author_name")).
-		Column(sqlc.Col("a.email").As("author_email")).
+		Column(sqlc.Coalesce(sqlc.Col("a.name"), sqlc.Param("")).As("author_name")).
+		Column(sqlc.Coalesce(sqlc.Col("a.email"), sqlc.Param("")).As("author_email")).
 		Where(sqlc.Col("p.status").Eq("published")).
 		OrderBy("p.created_at DESC").
 		WithClient(s.client).
@@ -430,6 +430,41 @@ func (s *BlogService) deleteComment(ctx context.Context, commentId int64) error
 
 // snippet-end: delete
 
+// snippet-start: delete limit
+func (s *BlogService) purgeDrafts(ctx context.Context, olderThan time.Time, batchSize int) (int64, error) {
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("batch size must be positive, got %d", batchSize)
+	}
+
+	var total int64
+
+	for {
+		result, err := sqlc.Delete("posts").
+			WithClient(s.client).
+			Where(sqlc.Col("status").Eq("draft")).
+			Where(sqlc.Col("created_at").Lt(olderThan)).
+			OrderBy("created_at ASC").
+			Limit(batchSize).
+			Exec(ctx)
+		if err != nil {
+			return total, fmt.Errorf("failed to delete drafts: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return total, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		total += rowsAffected
+
+		if rowsAffected < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+// snippet-end: delete limit
+
 // snippet-start: create comment
 func (s *BlogService) createComment(ctx context.Context, authorId, postId int64, body string) (*Comment, error) {
 	comment := &Comment{